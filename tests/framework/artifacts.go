@@ -0,0 +1,70 @@
+package framework
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+)
+
+// DescribeBackup runs `velero backup describe --details` against the given backup and
+// captures the output to ginkgo.GinkgoWriter and, when Framework.ArtifactsDir is set, to a
+// file under that directory so the details survive past the life of the test run.
+func (f *Framework) DescribeBackup(ctx context.Context, backupName, backupNamespace string) string {
+	return f.runVeleroCaptureCmd(ctx, fmt.Sprintf("%s-describe", backupName),
+		"backup", "describe", backupName, "-n", backupNamespace, "--details", "--insecure-skip-tls-verify")
+}
+
+// BackupLogs runs `velero backup logs` against the given backup and captures the output to
+// ginkgo.GinkgoWriter and, when Framework.ArtifactsDir is set, to a file under that directory.
+func (f *Framework) BackupLogs(ctx context.Context, backupName, backupNamespace string) string {
+	return f.runVeleroCaptureCmd(ctx, fmt.Sprintf("%s-logs", backupName),
+		"backup", "logs", backupName, "-n", backupNamespace, "--insecure-skip-tls-verify")
+}
+
+// DescribeRestore runs `velero restore describe --details` against the given restore and
+// captures the output the same way DescribeBackup does.
+func (f *Framework) DescribeRestore(ctx context.Context, restoreName, backupNamespace string) string {
+	return f.runVeleroCaptureCmd(ctx, fmt.Sprintf("%s-describe", restoreName),
+		"restore", "describe", restoreName, "-n", backupNamespace, "--details", "--insecure-skip-tls-verify")
+}
+
+// RestoreLogs runs `velero restore logs` against the given restore and captures the output the
+// same way BackupLogs does.
+func (f *Framework) RestoreLogs(ctx context.Context, restoreName, backupNamespace string) string {
+	return f.runVeleroCaptureCmd(ctx, fmt.Sprintf("%s-logs", restoreName),
+		"restore", "logs", restoreName, "-n", backupNamespace, "--insecure-skip-tls-verify")
+}
+
+// runVeleroCaptureCmd runs the velero CLI with the given args, writes the combined output to
+// ginkgo.GinkgoWriter so it shows up in the test log, and, when Framework.ArtifactsDir is
+// configured, also persists it to <ArtifactsDir>/<artifactName>.log for later inspection.
+func (f *Framework) runVeleroCaptureCmd(ctx context.Context, artifactName string, args ...string) string {
+	cmd := exec.CommandContext(ctx, "velero", args...)
+	var buf bytes.Buffer
+	cmd.Stdout = &buf
+	cmd.Stderr = &buf
+	ginkgo.By(fmt.Sprintf("capturing artifacts: %v", cmd))
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintf(&buf, "\nfailed to run %v: %v\n", cmd, err)
+	}
+	output := buf.String()
+	fmt.Fprintln(ginkgo.GinkgoWriter, output)
+
+	if f.ArtifactsDir != "" {
+		if err := os.MkdirAll(f.ArtifactsDir, 0755); err != nil {
+			fmt.Fprintf(ginkgo.GinkgoWriter, "failed to create artifacts dir %q: %v\n", f.ArtifactsDir, err)
+			return output
+		}
+		path := filepath.Join(f.ArtifactsDir, artifactName+".log")
+		if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+			fmt.Fprintf(ginkgo.GinkgoWriter, "failed to write artifact %q: %v\n", path, err)
+		}
+	}
+
+	return output
+}
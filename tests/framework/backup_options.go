@@ -0,0 +1,237 @@
+package framework
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BackupOptions holds the full set of knobs RunBackupScript can forward to a backup,
+// whether it is driven through a configured BackupScript or through the velero CLI
+// directly. Fields left at their zero value are omitted from the resulting command.
+type BackupOptions struct {
+	// IncludedNamespaces is the namespace (or comma-separated namespaces) to back up.
+	IncludedNamespaces string
+	// ExcludeNamespaces is a comma-separated list of namespaces to exclude from the backup.
+	ExcludeNamespaces string
+	// Resources is a comma-separated list of resource types to include in the backup.
+	Resources string
+	// IncludeClusterScopedResources is a comma-separated list of cluster-scoped resource
+	// types to include in the backup.
+	IncludeClusterScopedResources string
+	// ExcludeClusterScopedResources is a comma-separated list of cluster-scoped resource
+	// types to exclude from the backup.
+	ExcludeClusterScopedResources string
+	// IncludeNamespaceScopedResources is a comma-separated list of namespace-scoped
+	// resource types to include in the backup.
+	IncludeNamespaceScopedResources string
+	// ExcludeNamespaceScopedResources is a comma-separated list of namespace-scoped
+	// resource types to exclude from the backup.
+	ExcludeNamespaceScopedResources string
+	// Selector restricts the backup to resources matching this label selector.
+	Selector string
+	// OrLabelSelectors restricts the backup to resources matching any of these
+	// comma-separated label selectors.
+	OrLabelSelectors string
+	// SnapshotLocation is the name of the VolumeSnapshotLocation to use.
+	SnapshotLocation string
+	// ResourceModifierConfigMap is the name of the ConfigMap holding the resource
+	// modifier rules to apply to restored resources.
+	ResourceModifierConfigMap string
+	// DataMover selects the data mover plugin used for snapshot data movement.
+	DataMover string
+	// SnapshotMoveData enables moving snapshot data to the backup storage location.
+	SnapshotMoveData bool
+	// DefaultVolumesToFsBackup forces pod volume (file system) backup for all volumes.
+	DefaultVolumesToFsBackup bool
+	// TTL is how long the backup should be retained before velero garbage collects it.
+	TTL time.Duration
+	// PostBackupVerify, when set, makes RunBackupScript call VerifyCSISnapshots against
+	// IncludedNamespaces once the backup reaches BackupPhaseCompleted, so KubeVirt tests
+	// can assert VM disk snapshots really landed rather than only that the Backup CR
+	// turned green.
+	PostBackupVerify bool
+	// PostBackupVerifyTimeout bounds how long RunBackupScript waits for DataUpload CRs
+	// to complete when SnapshotMoveData and PostBackupVerify are both set. Defaults to
+	// 5 minutes when zero.
+	PostBackupVerifyTimeout time.Duration
+}
+
+// args translates the populated fields of o into velero backup create / backup-script flags.
+func (o BackupOptions) args() []string {
+	var args []string
+	if o.IncludedNamespaces != "" {
+		args = append(args, "-i", o.IncludedNamespaces)
+	}
+	if o.ExcludeNamespaces != "" {
+		args = append(args, "--exclude-namespaces", o.ExcludeNamespaces)
+	}
+	if o.Resources != "" {
+		args = append(args, "-r", o.Resources)
+	}
+	if o.IncludeClusterScopedResources != "" {
+		args = append(args, "--include-cluster-scoped-resources", o.IncludeClusterScopedResources)
+	}
+	if o.ExcludeClusterScopedResources != "" {
+		args = append(args, "--exclude-cluster-scoped-resources", o.ExcludeClusterScopedResources)
+	}
+	if o.IncludeNamespaceScopedResources != "" {
+		args = append(args, "--include-namespace-scoped-resources", o.IncludeNamespaceScopedResources)
+	}
+	if o.ExcludeNamespaceScopedResources != "" {
+		args = append(args, "--exclude-namespace-scoped-resources", o.ExcludeNamespaceScopedResources)
+	}
+	if o.Selector != "" {
+		args = append(args, "-s", o.Selector)
+	}
+	if o.OrLabelSelectors != "" {
+		args = append(args, "--or-selector", o.OrLabelSelectors)
+	}
+	if o.SnapshotLocation != "" {
+		args = append(args, "-l", o.SnapshotLocation)
+	}
+	if o.ResourceModifierConfigMap != "" {
+		args = append(args, "--resource-modifier-configmap", o.ResourceModifierConfigMap)
+	}
+	if o.DataMover != "" {
+		args = append(args, "--data-mover", o.DataMover)
+	}
+	if o.SnapshotMoveData {
+		args = append(args, "--snapshot-move-data")
+	}
+	if o.DefaultVolumesToFsBackup {
+		args = append(args, "--default-volumes-to-fs-backup")
+	}
+	if o.TTL > 0 {
+		args = append(args, "--ttl", o.TTL.String())
+	}
+	return args
+}
+
+// unsupportedForDirectAPI lists the set fields of o that runVeleroCLIBackup's Go-client
+// path (CreateBackupForResources/Selector/Namespace) has no way to apply. Those helpers
+// only understand Resources, Selector, IncludedNamespaces and SnapshotLocation; every
+// other option here requires a configured BackupScript to be translated into the
+// matching velero flag.
+func (o BackupOptions) unsupportedForDirectAPI() []string {
+	var unsupported []string
+	if o.ExcludeNamespaces != "" {
+		unsupported = append(unsupported, "ExcludeNamespaces")
+	}
+	if o.IncludeClusterScopedResources != "" {
+		unsupported = append(unsupported, "IncludeClusterScopedResources")
+	}
+	if o.ExcludeClusterScopedResources != "" {
+		unsupported = append(unsupported, "ExcludeClusterScopedResources")
+	}
+	if o.IncludeNamespaceScopedResources != "" {
+		unsupported = append(unsupported, "IncludeNamespaceScopedResources")
+	}
+	if o.ExcludeNamespaceScopedResources != "" {
+		unsupported = append(unsupported, "ExcludeNamespaceScopedResources")
+	}
+	if o.OrLabelSelectors != "" {
+		unsupported = append(unsupported, "OrLabelSelectors")
+	}
+	if o.ResourceModifierConfigMap != "" {
+		unsupported = append(unsupported, "ResourceModifierConfigMap")
+	}
+	if o.DataMover != "" {
+		unsupported = append(unsupported, "DataMover")
+	}
+	if o.SnapshotMoveData {
+		unsupported = append(unsupported, "SnapshotMoveData")
+	}
+	if o.DefaultVolumesToFsBackup {
+		unsupported = append(unsupported, "DefaultVolumesToFsBackup")
+	}
+	if o.TTL > 0 {
+		unsupported = append(unsupported, "TTL")
+	}
+	return unsupported
+}
+
+// validateForDirectAPI returns an error naming any BackupOptions field that
+// runVeleroCLIBackup cannot apply without a configured BackupScript, instead of letting
+// the field be silently dropped.
+func (o BackupOptions) validateForDirectAPI() error {
+	if unsupported := o.unsupportedForDirectAPI(); len(unsupported) > 0 {
+		return fmt.Errorf("BackupOptions field(s) %s require a configured BackupScript; "+
+			"the direct velero-client backup path does not translate them", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// RestoreOptions holds the full set of knobs RunRestoreScript can forward to a restore,
+// whether it is driven through a configured BackupScript or through the velero CLI
+// directly. Fields left at their zero value are omitted from the resulting command.
+type RestoreOptions struct {
+	// ExistingResourcePolicy controls how the restore treats resources that already
+	// exist in the cluster (e.g. "none" or "update").
+	ExistingResourcePolicy string
+	// ResourceModifierConfigMap is the name of the ConfigMap holding the resource
+	// modifier rules to apply to restored resources.
+	ResourceModifierConfigMap string
+	// NamespaceMappings remaps namespaces during restore, e.g. "source:target".
+	NamespaceMappings string
+	// PreserveNodePorts keeps the original NodePort values of restored Services.
+	PreserveNodePorts bool
+	// WriteSparseFiles restores pod volume backups as sparse files.
+	WriteSparseFiles bool
+}
+
+// args translates the populated fields of o into velero restore create / backup-script flags.
+func (o RestoreOptions) args() []string {
+	var args []string
+	if o.ExistingResourcePolicy != "" {
+		args = append(args, "--existing-resource-policy", o.ExistingResourcePolicy)
+	}
+	if o.ResourceModifierConfigMap != "" {
+		args = append(args, "--resource-modifier-configmap", o.ResourceModifierConfigMap)
+	}
+	if o.NamespaceMappings != "" {
+		args = append(args, "--namespace-mappings", o.NamespaceMappings)
+	}
+	if o.PreserveNodePorts {
+		args = append(args, "--preserve-nodeports")
+	}
+	if o.WriteSparseFiles {
+		args = append(args, "--write-sparse-files")
+	}
+	return args
+}
+
+// unsupportedForDirectAPI lists the set fields of o that runVeleroCLIRestore's Go-client
+// path (CreateRestoreForBackup) has no way to apply. That helper takes no restore options
+// at all, so every populated RestoreOptions field requires a configured BackupScript to be
+// translated into the matching velero flag.
+func (o RestoreOptions) unsupportedForDirectAPI() []string {
+	var unsupported []string
+	if o.ExistingResourcePolicy != "" {
+		unsupported = append(unsupported, "ExistingResourcePolicy")
+	}
+	if o.ResourceModifierConfigMap != "" {
+		unsupported = append(unsupported, "ResourceModifierConfigMap")
+	}
+	if o.NamespaceMappings != "" {
+		unsupported = append(unsupported, "NamespaceMappings")
+	}
+	if o.PreserveNodePorts {
+		unsupported = append(unsupported, "PreserveNodePorts")
+	}
+	if o.WriteSparseFiles {
+		unsupported = append(unsupported, "WriteSparseFiles")
+	}
+	return unsupported
+}
+
+// validateForDirectAPI returns an error naming any RestoreOptions field that
+// runVeleroCLIRestore cannot apply without a configured BackupScript, instead of letting
+// the field be silently dropped.
+func (o RestoreOptions) validateForDirectAPI() error {
+	if unsupported := o.unsupportedForDirectAPI(); len(unsupported) > 0 {
+		return fmt.Errorf("RestoreOptions field(s) %s require a configured BackupScript; "+
+			"the direct velero-client restore path does not translate them", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
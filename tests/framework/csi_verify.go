@@ -0,0 +1,71 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const backupNameLabel = "velero.io/backup-name"
+
+// VerifyCSISnapshots checks the CSI VolumeSnapshots in ns that carry backupName's
+// velero.io/backup-name label: there must be at least one, and each must be ReadyToUse
+// and bound to a VolumeSnapshotContent that is itself ReadyToUse with a non-empty
+// snapshotHandle. It does not check that every DataVolume/PVC in ns has a VolumeSnapshot,
+// and it does not look at object storage; the DataUploads of a SnapshotMoveData backup
+// are checked separately by waitForDataUploadsCompleted.
+func (f *Framework) VerifyCSISnapshots(ctx context.Context, backupName, ns string) error {
+	snapshots, err := ListVolumeSnapshots(ctx, ns, backupNameLabel+"="+backupName)
+	if err != nil {
+		return fmt.Errorf("listing VolumeSnapshots for backup %q: %w", backupName, err)
+	}
+	if len(snapshots) == 0 {
+		return fmt.Errorf("backup %q produced no VolumeSnapshots in namespace %q", backupName, ns)
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.Status == nil || snapshot.Status.ReadyToUse == nil || !*snapshot.Status.ReadyToUse {
+			return fmt.Errorf("VolumeSnapshot %q for backup %q is not ready to use", snapshot.Name, backupName)
+		}
+		if snapshot.Status.BoundVolumeSnapshotContentName == nil {
+			return fmt.Errorf("VolumeSnapshot %q for backup %q has no bound VolumeSnapshotContent", snapshot.Name, backupName)
+		}
+
+		content, err := GetVolumeSnapshotContent(ctx, *snapshot.Status.BoundVolumeSnapshotContentName)
+		if err != nil {
+			return fmt.Errorf("getting VolumeSnapshotContent %q for backup %q: %w", *snapshot.Status.BoundVolumeSnapshotContentName, backupName, err)
+		}
+		if content.Status == nil || content.Status.ReadyToUse == nil || !*content.Status.ReadyToUse {
+			return fmt.Errorf("VolumeSnapshotContent %q for backup %q is not ready to use", content.Name, backupName)
+		}
+		if content.Status.SnapshotHandle == nil || *content.Status.SnapshotHandle == "" {
+			return fmt.Errorf("VolumeSnapshotContent %q for backup %q has an empty snapshotHandle", content.Name, backupName)
+		}
+	}
+
+	return nil
+}
+
+// waitForDataUploadsCompleted waits for every DataUpload created for backupName to reach
+// DataUploadPhaseCompleted, used when SnapshotMoveData moves the CSI snapshot data out to
+// the backup storage location instead of leaving it as a volume snapshot.
+func (f *Framework) waitForDataUploadsCompleted(ctx context.Context, backupName, backupNamespace string, timeout time.Duration) error {
+	return wait.PollUntilContextTimeout(ctx, 10*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		dataUploads, err := ListDataUploads(ctx, backupNamespace, backupNameLabel+"="+backupName)
+		if err != nil {
+			return false, err
+		}
+		if len(dataUploads) == 0 {
+			return false, nil
+		}
+		for _, du := range dataUploads {
+			if du.Status.Phase != velerov2alpha1api.DataUploadPhaseCompleted {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
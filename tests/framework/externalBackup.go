@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 	"time"
 
 	ginkgo "github.com/onsi/ginkgo/v2"
@@ -14,27 +15,16 @@ import (
 // RunBackupScript runs the configured backup-restore script.
 // The script will run with appropriate args and verify backup completed successfully
 // if script flag was not define, we will run veleroCLI instead
-func (f *Framework) RunBackupScript(ctx context.Context, backupName, resources, selector, includedNamespace, snapshotLocation, backupNamespace string) error {
+func (f *Framework) RunBackupScript(ctx context.Context, backupName string, opts BackupOptions, backupNamespace string) error {
+	backupName = f.ShardedName(backupName)
 	if f.BackupScript.BackupScript == "" {
-		return runVeleroCLIBackup(ctx, backupName, resources, selector, includedNamespace, snapshotLocation, backupNamespace)
+		return f.runVeleroCLIBackup(ctx, backupName, opts, backupNamespace)
 	}
-	args := []string{
+	args := append([]string{
 		"backup", backupName,
-		"-i", includedNamespace,
 		"-n", backupNamespace,
 		"-v",
-	}
-
-	if resources != "" {
-		args = append(args, "-r", resources)
-	}
-	if selector != "" {
-		args = append(args, "-s", selector)
-	}
-
-	if snapshotLocation != "" {
-		args = append(args, "-l", snapshotLocation)
-	}
+	}, opts.args()...)
 
 	backupCmd := exec.CommandContext(ctx, f.BackupScript.BackupScript, args...)
 	backupCmd.Stdout = os.Stdout
@@ -42,46 +32,113 @@ func (f *Framework) RunBackupScript(ctx context.Context, backupName, resources,
 	ginkgo.By(fmt.Sprintf("backup cmd =%v\n", backupCmd))
 	err := backupCmd.Run()
 	if err != nil {
+		f.DescribeBackup(ctx, backupName, backupNamespace)
+		f.BackupLogs(ctx, backupName, backupNamespace)
 		return err
 	}
 
+	return f.runPostBackupVerify(ctx, backupName, opts, backupNamespace)
+}
+
+// runPostBackupVerify runs the CSI snapshot verification RunBackupScript callers opt into
+// via BackupOptions.PostBackupVerify, once the backup itself has already completed.
+// IncludedNamespaces may be a comma-separated list, so VerifyCSISnapshots (which only
+// understands a single namespace) is run once per included namespace.
+func (f *Framework) runPostBackupVerify(ctx context.Context, backupName string, opts BackupOptions, backupNamespace string) error {
+	if !opts.PostBackupVerify {
+		return nil
+	}
+	for _, ns := range strings.Split(opts.IncludedNamespaces, ",") {
+		ns = strings.TrimSpace(ns)
+		if ns == "" {
+			continue
+		}
+		if err := f.VerifyCSISnapshots(ctx, backupName, ns); err != nil {
+			return err
+		}
+	}
+	if opts.SnapshotMoveData {
+		timeout := opts.PostBackupVerifyTimeout
+		if timeout == 0 {
+			timeout = 5 * time.Minute
+		}
+		if err := f.waitForDataUploadsCompleted(ctx, backupName, backupNamespace, timeout); err != nil {
+			return fmt.Errorf("waiting for DataUploads of backup %q to complete: %w", backupName, err)
+		}
+	}
 	return nil
 }
 
-func runVeleroCLIBackup(ctx context.Context, backupName, resources, selector, includedNamespace, snapshotLocation, backupNamespace string) error {
+// runVeleroCLIBackup drives a backup through the existing Go-client helpers. Only the
+// fields those helpers understand (resources, selector, included namespace, snapshot
+// location) are honored here; the rest of BackupOptions is only translated when a
+// BackupScript is configured.
+func (f *Framework) runVeleroCLIBackup(ctx context.Context, backupName string, opts BackupOptions, backupNamespace string) error {
+	if err := opts.validateForDirectAPI(); err != nil {
+		return err
+	}
+
 	var err error
-	if resources != "" {
-		err = CreateBackupForResources(ctx, backupName, resources, includedNamespace, snapshotLocation, backupNamespace, true)
-	} else if selector != "" {
-		err = CreateBackupForSelector(ctx, backupName, selector, includedNamespace, snapshotLocation, backupNamespace, true)
-	} else {
-		err = CreateBackupForNamespace(ctx, backupName, includedNamespace, snapshotLocation, backupNamespace, true)
+	switch {
+	case opts.Resources != "":
+		err = CreateBackupForResources(ctx, backupName, opts.Resources, opts.IncludedNamespaces, opts.SnapshotLocation, backupNamespace, true)
+	case opts.Selector != "":
+		err = CreateBackupForSelector(ctx, backupName, opts.Selector, opts.IncludedNamespaces, opts.SnapshotLocation, backupNamespace, true)
+	default:
+		err = CreateBackupForNamespace(ctx, backupName, opts.IncludedNamespaces, opts.SnapshotLocation, backupNamespace, true)
 	}
 	if err != nil {
+		f.DescribeBackup(ctx, backupName, backupNamespace)
+		f.BackupLogs(ctx, backupName, backupNamespace)
 		return err
 	}
 	err = WaitForBackupPhase(ctx, backupName, backupNamespace, velerov1api.BackupPhaseCompleted)
+	if err != nil {
+		f.DescribeBackup(ctx, backupName, backupNamespace)
+		f.BackupLogs(ctx, backupName, backupNamespace)
+		return err
+	}
+	return f.runPostBackupVerify(ctx, backupName, opts, backupNamespace)
+}
+
+// runVeleroCLIRestore drives a restore through the existing Go-client helpers. Those
+// helpers take no RestoreOptions at all, so any populated RestoreOptions field requires a
+// configured BackupScript; see runVeleroCLIBackup for the same limitation on the backup
+// side.
+func (f *Framework) runVeleroCLIRestore(ctx context.Context, backupName, restoreName string, opts RestoreOptions, backupNamespace string) error {
+	if err := opts.validateForDirectAPI(); err != nil {
+		return err
+	}
+
+	err := CreateRestoreForBackup(ctx, backupName, restoreName, backupNamespace, true)
+	if err != nil {
+		f.DescribeRestore(ctx, restoreName, backupNamespace)
+		f.RestoreLogs(ctx, restoreName, backupNamespace)
+		return err
+	}
+	err = WaitForRestorePhase(ctx, restoreName, backupNamespace, velerov1api.RestorePhaseCompleted)
+	if err != nil {
+		f.DescribeRestore(ctx, restoreName, backupNamespace)
+		f.RestoreLogs(ctx, restoreName, backupNamespace)
+	}
 	return err
 }
 
 // RunRestoreScript runs the configured backup-restore script.
 // The script will run with appropriate args and verify backup completed successfully
 // if script flag was not define, we will run veleroCLI instead
-func (f *Framework) RunRestoreScript(ctx context.Context, backupName, restoreName string, backupNamespace string) error {
+func (f *Framework) RunRestoreScript(ctx context.Context, backupName, restoreName string, opts RestoreOptions, backupNamespace string) error {
+	backupName = f.ShardedName(backupName)
+	restoreName = f.ShardedName(restoreName)
 	if f.BackupScript.BackupScript == "" {
-		err := CreateRestoreForBackup(ctx, backupName, restoreName, backupNamespace, true)
-		if err != nil {
-			return err
-		}
-		err = WaitForRestorePhase(ctx, restoreName, backupNamespace, velerov1api.RestorePhaseCompleted)
-		return err
+		return f.runVeleroCLIRestore(ctx, backupName, restoreName, opts, backupNamespace)
 	}
-	args := []string{
+	args := append([]string{
 		"restore", restoreName,
 		"-f", backupName,
 		"-n", backupNamespace,
 		"-v",
-	}
+	}, opts.args()...)
 
 	restoreCmd := exec.CommandContext(ctx, f.BackupScript.BackupScript, args...)
 	restoreCmd.Stdout = os.Stdout
@@ -89,6 +146,8 @@ func (f *Framework) RunRestoreScript(ctx context.Context, backupName, restoreNam
 	ginkgo.By(fmt.Sprintf("restore cmd =%v\n", restoreCmd))
 	err := restoreCmd.Run()
 	if err != nil {
+		f.DescribeRestore(ctx, restoreName, backupNamespace)
+		f.RestoreLogs(ctx, restoreName, backupNamespace)
 		return err
 	}
 
@@ -99,6 +158,7 @@ func (f *Framework) RunRestoreScript(ctx context.Context, backupName, restoreNam
 // The script will run with appropriate args and verify backup completed successfully
 // if script flag was not define, we will run veleroCLI instead
 func (f *Framework) RunDeleteBackupScript(ctx context.Context, backupName string, backupNamespace string) error {
+	backupName = f.ShardedName(backupName)
 	if f.BackupScript.BackupScript == "" {
 		return DeleteBackup(ctx, backupName, backupNamespace)
 	}
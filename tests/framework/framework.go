@@ -0,0 +1,31 @@
+package framework
+
+// BackupScriptCommands configures the external script RunBackupScript and its siblings
+// shell out to for backup/restore/schedule subcommands (backup, restore, delete-backup,
+// schedule create, delete-schedule). When BackupScript is empty, those methods drive the
+// corresponding Backup/Restore/Schedule object directly instead of invoking a script.
+type BackupScriptCommands struct {
+	// BackupScript is the path to the backup-restore script. Leave empty to drive velero
+	// directly through its Go client instead.
+	BackupScript string
+}
+
+// Framework carries the configuration shared by the KVP e2e helpers in this package.
+type Framework struct {
+	// BackupScript selects whether backups/restores/schedules are driven through an
+	// external script or directly through the velero Go client.
+	BackupScript BackupScriptCommands
+	// ArtifactsDir is where DescribeBackup, BackupLogs, DescribeRestore and RestoreLogs
+	// persist their captured output, in addition to writing it to ginkgo.GinkgoWriter.
+	// Leave empty to only log to GinkgoWriter.
+	ArtifactsDir string
+	// Shard controls this binary's slice of the suite when the KVP e2e run is split
+	// across a SHARD_INDEX/SHARD_TOTAL CI matrix. See Describe and It.
+	Shard ShardConfig
+
+	// describeStack tracks the text of the Describe containers currently being
+	// registered, so It can hash the full container+leaf description as a single
+	// partition key. It is only mutated while ginkgo builds its spec tree, which is
+	// single-threaded, so no locking is needed.
+	describeStack []string
+}
@@ -0,0 +1,139 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ScheduleOptions holds the knobs RunScheduleScript forwards to the created Schedule, on
+// top of the BackupOptions applied to every backup the schedule produces.
+type ScheduleOptions struct {
+	BackupOptions
+	// Paused creates the schedule in a paused state, so it does not start ticking
+	// until explicitly triggered or unpaused.
+	Paused bool
+}
+
+// CreateScheduleForOptions translates opts into the backup template args CreateSchedule
+// applies to every backup the schedule produces, then creates the Schedule CRD. It exists
+// because CreateSchedule itself predates BackupOptions and only understands plain
+// velero flags, not the struct RunScheduleScript's callers configure schedules with.
+func CreateScheduleForOptions(ctx context.Context, scheduleName, cronExpr string, opts BackupOptions, paused bool, backupNamespace string) error {
+	return CreateSchedule(ctx, scheduleName, cronExpr, opts.args(), paused, backupNamespace)
+}
+
+// RunScheduleScript creates a velero Schedule named scheduleName running on cronExpr.
+// When BackupScript is configured, it forwards `schedule create` to the script with the
+// same option translation RunBackupScript uses for one-off backups. Otherwise it drives
+// the Schedule CRD directly, the same way runVeleroCLIBackup/runVeleroCLIRestore drive
+// Backups/Restores directly instead of shelling out to the velero CLI.
+func (f *Framework) RunScheduleScript(ctx context.Context, scheduleName, cronExpr string, opts ScheduleOptions, backupNamespace string) error {
+	scheduleName = f.ShardedName(scheduleName)
+	if f.BackupScript.BackupScript == "" {
+		return CreateScheduleForOptions(ctx, scheduleName, cronExpr, opts.BackupOptions, opts.Paused, backupNamespace)
+	}
+
+	args := append([]string{
+		"schedule", "create", scheduleName,
+		"--schedule", cronExpr,
+		"-n", backupNamespace,
+		"-v",
+	}, opts.BackupOptions.args()...)
+	if opts.Paused {
+		args = append(args, "--paused")
+	}
+
+	scheduleCmd := exec.CommandContext(ctx, f.BackupScript.BackupScript, args...)
+	scheduleCmd.Stdout = os.Stdout
+	scheduleCmd.Stderr = os.Stderr
+	ginkgo.By(fmt.Sprintf("schedule cmd =%v\n", scheduleCmd))
+	return scheduleCmd.Run()
+}
+
+// DeleteSchedule deletes the named Schedule, either via the configured BackupScript's
+// delete-schedule subcommand or directly against the Schedule CRD.
+func (f *Framework) DeleteSchedule(ctx context.Context, scheduleName, backupNamespace string) error {
+	scheduleName = f.ShardedName(scheduleName)
+	if f.BackupScript.BackupScript == "" {
+		return DeleteScheduleObj(ctx, scheduleName, backupNamespace)
+	}
+
+	cmd := exec.CommandContext(ctx, f.BackupScript.BackupScript, "delete-schedule", scheduleName, "-n", backupNamespace)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	ginkgo.By(fmt.Sprintf("delete schedule cmd =%v\n", cmd))
+	return cmd.Run()
+}
+
+// TriggerScheduleNow forces an immediate run of scheduleName instead of waiting for its
+// next cron tick, handy for tests that only care about the schedule->backup wiring and
+// don't want to wait out a real cron interval. Like its siblings above, it respects
+// BackupScript when one is configured and otherwise drives the CRDs directly.
+func (f *Framework) TriggerScheduleNow(ctx context.Context, scheduleName, backupNamespace string) error {
+	scheduleName = f.ShardedName(scheduleName)
+	backupName := fmt.Sprintf("%s-manual", scheduleName)
+
+	if f.BackupScript.BackupScript == "" {
+		return CreateBackupFromSchedule(ctx, backupName, scheduleName, backupNamespace)
+	}
+
+	cmd := exec.CommandContext(ctx, f.BackupScript.BackupScript,
+		"backup", backupName,
+		"--from-schedule", scheduleName,
+		"-n", backupNamespace, "-v")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	ginkgo.By(fmt.Sprintf("trigger schedule cmd =%v\n", cmd))
+	return cmd.Run()
+}
+
+// WaitForScheduledBackup polls for the first Backup owned by scheduleName that was
+// created at or after since, then waits for it to reach BackupPhaseCompleted the same
+// way RunBackupScript does for a one-off backup. It returns the name of that backup.
+func (f *Framework) WaitForScheduledBackup(ctx context.Context, scheduleName, backupNamespace string, since time.Time, timeout time.Duration) (string, error) {
+	scheduleName = f.ShardedName(scheduleName)
+	var backupName string
+	err := wait.PollUntilContextTimeout(ctx, 10*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		backups, err := ListBackups(ctx, backupNamespace)
+		if err != nil {
+			return false, err
+		}
+		for _, backup := range backups {
+			if !ownedBySchedule(backup, scheduleName) {
+				continue
+			}
+			if backup.CreationTimestamp.Time.Before(since) {
+				continue
+			}
+			backupName = backup.Name
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("waiting for schedule %q to produce a backup: %w", scheduleName, err)
+	}
+
+	if err := WaitForBackupPhase(ctx, backupName, backupNamespace, velerov1api.BackupPhaseCompleted); err != nil {
+		f.DescribeBackup(ctx, backupName, backupNamespace)
+		f.BackupLogs(ctx, backupName, backupNamespace)
+		return backupName, err
+	}
+	return backupName, nil
+}
+
+func ownedBySchedule(backup velerov1api.Backup, scheduleName string) bool {
+	for _, ref := range backup.OwnerReferences {
+		if ref.Kind == "Schedule" && ref.Name == scheduleName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package framework
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+)
+
+// ShardConfig lets a single KVP e2e binary run as one of several parallel shards, the same
+// way upstream Velero splits its e2e job across a CI matrix (basic VM, DataVolume,
+// instancetype, snapshot-move-data, ...). It is populated from the environment so CI only
+// has to set env vars per matrix entry, not pass extra ginkgo flags.
+type ShardConfig struct {
+	// Focus is a regular expression; specs whose full text does not match it are skipped.
+	// Empty means "match everything".
+	Focus string
+	// Skip is a regular expression; specs whose full text matches it are skipped, even if
+	// they also match Focus.
+	Skip string
+	// Index is this shard's zero-based position, read from SHARD_INDEX (default 0).
+	Index int
+	// Total is the number of shards the suite is split across, read from SHARD_TOTAL
+	// (default 1, meaning sharding is disabled).
+	Total int
+}
+
+// NewShardConfigFromEnv builds a ShardConfig from GINKGO_FOCUS, GINKGO_SKIP, SHARD_INDEX
+// and SHARD_TOTAL. Unset or unparsable SHARD_INDEX/SHARD_TOTAL fall back to 0/1, i.e. a
+// single, unsharded shard that runs everything.
+func NewShardConfigFromEnv() ShardConfig {
+	cfg := ShardConfig{
+		Focus: os.Getenv("GINKGO_FOCUS"),
+		Skip:  os.Getenv("GINKGO_SKIP"),
+		Index: 0,
+		Total: 1,
+	}
+	if index, err := strconv.Atoi(os.Getenv("SHARD_INDEX")); err == nil && index >= 0 {
+		cfg.Index = index
+	}
+	if total, err := strconv.Atoi(os.Getenv("SHARD_TOTAL")); err == nil && total > 0 {
+		cfg.Total = total
+	}
+	return cfg
+}
+
+// Matches reports whether a spec with the given description should run under this shard:
+// it must match Focus (when set), must not match Skip (when set), and must partition to
+// this shard's Index out of Total via a stable hash of its description. Without that last
+// check every shard in a SHARD_INDEX/SHARD_TOTAL matrix would run the identical set of
+// specs, defeating the point of sharding.
+func (s ShardConfig) Matches(description string) bool {
+	if s.Skip != "" {
+		if matched, _ := regexp.MatchString(s.Skip, description); matched {
+			return false
+		}
+	}
+	if s.Focus != "" {
+		if matched, _ := regexp.MatchString(s.Focus, description); !matched {
+			return false
+		}
+	}
+	return s.partition(description)
+}
+
+// partition reports whether description belongs to this shard, deterministically
+// distributing specs across Total shards by hashing their description. Disabled sharding
+// (Total <= 1) always returns true.
+func (s ShardConfig) partition(description string) bool {
+	if !s.Enabled() {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(description))
+	return int(h.Sum32()%uint32(s.Total)) == s.Index
+}
+
+// Enabled reports whether sharding is actually active, i.e. the suite was split into more
+// than one shard.
+func (s ShardConfig) Enabled() bool {
+	return s.Total > 1
+}
+
+// id returns the suffix Run*Script uses to tag resource names created under this shard, so
+// concurrent shards targeting the same BSL bucket don't collide.
+func (s ShardConfig) id() string {
+	return fmt.Sprintf("shard%d", s.Index)
+}
+
+// ShardedName returns name unchanged when sharding is disabled, and name tagged with this
+// shard's id otherwise. RunBackupScript, RunRestoreScript and RunScheduleScript all derive
+// their actual resource names through this, so two calls with the same logical name from
+// the same shard always agree on the real name without having to thread it back to the
+// caller.
+func (f *Framework) ShardedName(name string) string {
+	if !f.Shard.Enabled() {
+		return name
+	}
+	return fmt.Sprintf("%s-%s", name, f.Shard.id())
+}
+
+// Describe wraps ginkgo.Describe. It always registers the container itself — the
+// Shard decision is made once per leaf spec, in It, against that spec's full
+// container+leaf description, so nesting f.Describe does not compound with f.It into
+// independent per-level hashes. Describe tracks its text on the Framework so nested It
+// calls can see the full path; suites should register shardable containers through this
+// instead of calling ginkgo.Describe directly whenever specs inside use f.It.
+func (f *Framework) Describe(text string, body func()) bool {
+	// Push the text inside the container body: ginkgo defers the bodies of top-level
+	// containers until it builds the spec tree, so pushing around the ginkgo.Describe call
+	// itself would leave the stack empty by the time their It calls run.
+	return ginkgo.Describe(text, func() {
+		f.describeStack = append(f.describeStack, text)
+		defer func() { f.describeStack = f.describeStack[:len(f.describeStack)-1] }()
+		body()
+	})
+}
+
+// It wraps ginkgo.It, registering the spec as pending instead of active when the full
+// description (every enclosing f.Describe text plus this spec's own text) doesn't match
+// the Framework's Shard configuration. Using the full combined description as the single
+// partition key, rather than hashing each nesting level independently, is what makes a
+// nested spec land on exactly one shard instead of landing pending on all of them.
+func (f *Framework) It(text string, body func()) bool {
+	full := strings.Join(append(append([]string{}, f.describeStack...), text), " ")
+	if !f.Shard.Matches(full) {
+		return ginkgo.PIt(text, body)
+	}
+	return ginkgo.It(text, body)
+}